@@ -22,12 +22,19 @@ Notes:
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"math"
+	"reflect"
 	"testing"
+	"testing/quick"
 	"time"
 
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/sha3"
+
 	"github.com/hydraresearch/qzkp/src/classical"
 	"github.com/hydraresearch/qzkp/src/security"
 )
@@ -37,6 +44,14 @@ func TestReviewerSuite(t *testing.T) {
 	t.Run("SecureProtocolNoDirectInclusion", testSecureProtocolNoDirectInclusion)
 	t.Run("SoundnessMappingSanity", testSoundnessMappingSanity)
 	t.Run("RNGVariabilitySanity", testRNGVariabilitySanity)
+	t.Run("BatchVerifyLocalizesBadProof", testBatchVerifyLocalizesBadProof)
+	t.Run("MaskedCommitmentHiding", testMaskedCommitmentHiding)
+	t.Run("CrossHashTranscriptBinding", testCrossHashTranscriptBinding)
+	t.Run("WireRoundTrip", testWireRoundTrip)
+	t.Run("SealedWitnessAADBinding", testSealedWitnessAADBinding)
+	t.Run("SealedWitnessNonceUniqueness", testSealedWitnessNonceUniqueness)
+	t.Run("SealedWitnessNoPlaintextLeakage", testSealedWitnessNoPlaintextLeakage)
+	t.Run("CommitSealedRoundTrip", testCommitSealedRoundTrip)
 }
 
 // --- Core tests (condensed) ---
@@ -76,14 +91,32 @@ func testInformationLeakageDetection(t *testing.T) {
 }
 
 func testSecureProtocolNoDirectInclusion(t *testing.T) {
-	// Generates a proof and ensures the proof transcript doesn't contain a naive
-	// serialization of the underlying witness bytes.
+	t.Run("SHA256", func(t *testing.T) {
+		testSecureProtocolNoDirectInclusionWithHash(t)
+	})
+	t.Run("BLAKE2b", func(t *testing.T) {
+		h, err := blake2b.New256(nil)
+		if err != nil {
+			t.Fatalf("blake2b.New256: %v", err)
+		}
+		testSecureProtocolNoDirectInclusionWithHash(t, security.WithTranscriptHash(h))
+	})
+	t.Run("SHA3_256", func(t *testing.T) {
+		testSecureProtocolNoDirectInclusionWithHash(t, security.WithTranscriptHash(sha3.New256()))
+	})
+}
+
+// testSecureProtocolNoDirectInclusionWithHash generates a proof under the
+// given transcript hash option (SHA-256 if none is given) and ensures the
+// proof transcript doesn't contain a naive serialization of the
+// underlying witness bytes.
+func testSecureProtocolNoDirectInclusionWithHash(t *testing.T, opts ...security.Option) {
 	witness := make([]byte, 32)
 	if _, err := rand.Read(witness); err != nil {
 		t.Fatalf("rand.Read: %v", err)
 	}
 
-	sec := security.NewSecureQuantumZKP(256)
+	sec := security.NewSecureQuantumZKP(256, opts...)
 	commitment, err := sec.Commit(witness)
 	if err != nil {
 		t.Fatalf("Commit: %v", err)
@@ -151,10 +184,308 @@ func testRNGVariabilitySanity(t *testing.T) {
 	}
 }
 
+func testBatchVerifyLocalizesBadProof(t *testing.T) {
+	const n = 64
+
+	sec := security.NewSecureQuantumZKP(256)
+	commitments := make([]*security.Commitment, n)
+	proofs := make([]*security.Proof, n)
+
+	for i := 0; i < n; i++ {
+		witness := make([]byte, 32)
+		if _, err := rand.Read(witness); err != nil {
+			t.Fatalf("rand.Read: %v", err)
+		}
+		commitment, err := sec.Commit(witness)
+		if err != nil {
+			t.Fatalf("Commit: %v", err)
+		}
+		proof, err := sec.Prove(commitment)
+		if err != nil {
+			t.Fatalf("Prove: %v", err)
+		}
+		commitments[i] = commitment
+		proofs[i] = proof
+	}
+
+	// Sanity: a clean batch of 64 passes.
+	ok, bad, err := sec.VerifyBatch(commitments, proofs)
+	if err != nil {
+		t.Fatalf("VerifyBatch (clean): %v", err)
+	}
+	if !ok || len(bad) != 0 {
+		t.Fatalf("VerifyBatch (clean): expected ok=true, no bad indices; got ok=%v bad=%v", ok, bad)
+	}
+
+	// Inject one bad proof and confirm VerifyBatch localizes it.
+	const injected = 37
+	tampered := *proofs[injected]
+	tampered.Responses = append([][]byte(nil), proofs[injected].Responses...)
+	tampered.Responses[0] = append([]byte(nil), tampered.Responses[0]...)
+	tampered.Responses[0][0] ^= 0xFF
+	proofs[injected] = &tampered
+
+	ok, bad, err = sec.VerifyBatch(commitments, proofs)
+	if err != nil {
+		t.Fatalf("VerifyBatch (tampered): %v", err)
+	}
+	if ok {
+		t.Fatalf("VerifyBatch (tampered): expected ok=false")
+	}
+	if len(bad) != 1 || bad[0] != injected {
+		t.Fatalf("VerifyBatch (tampered): expected bad=[%d], got %v", injected, bad)
+	}
+}
+
+func testMaskedCommitmentHiding(t *testing.T) {
+	key := []byte("security-test-key-32bytes-length")
+	v := []byte("AAAAAAAAAAAAAAAA")
+
+	c1, o1, err := classical.CreateMaskedCommitment(v, key, rand.Reader)
+	if err != nil {
+		t.Fatalf("CreateMaskedCommitment: %v", err)
+	}
+	c2, o2, err := classical.CreateMaskedCommitment(v, key, rand.Reader)
+	if err != nil {
+		t.Fatalf("CreateMaskedCommitment: %v", err)
+	}
+
+	// Hiding: two commitments to the same (v, key) must not be identical.
+	if bytes.Equal(c1.Digest, c2.Digest) {
+		t.Fatalf("two masked commitments to the same (v, key) produced identical digests")
+	}
+
+	// Each commitment opens under its own opening...
+	if !classical.VerifyMaskedCommitment(c1, v, key, o1) {
+		t.Fatalf("VerifyMaskedCommitment rejected the correct opening for c1")
+	}
+	if !classical.VerifyMaskedCommitment(c2, v, key, o2) {
+		t.Fatalf("VerifyMaskedCommitment rejected the correct opening for c2")
+	}
+	// ...but not under the other's.
+	if classical.VerifyMaskedCommitment(c1, v, key, o2) {
+		t.Fatalf("VerifyMaskedCommitment accepted c1 with c2's opening")
+	}
+}
+
+func testCrossHashTranscriptBinding(t *testing.T) {
+	witness := make([]byte, 32)
+	if _, err := rand.Read(witness); err != nil {
+		t.Fatalf("rand.Read: %v", err)
+	}
+
+	secSHA := security.NewSecureQuantumZKP(256)
+	commitment, err := secSHA.Commit(witness)
+	if err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	proof, err := secSHA.Prove(commitment)
+	if err != nil {
+		t.Fatalf("Prove: %v", err)
+	}
+
+	h, err := blake2b.New256(nil)
+	if err != nil {
+		t.Fatalf("blake2b.New256: %v", err)
+	}
+	secBLAKE := security.NewSecureQuantumZKP(256, security.WithTranscriptHash(h))
+
+	// A proof produced under one transcript hash must not verify under a
+	// differently-configured instance.
+	ok, err := secBLAKE.Verify(commitment, proof)
+	if err == nil && ok {
+		t.Fatalf("Verify succeeded across different transcript hashes; expected rejection")
+	}
+}
+
+// testWireRoundTrip fuzz-lite checks that Unmarshal(Marshal(x)) == x for
+// both Commitment and Proof, that the round-tripped values still verify,
+// and that flipping a single byte of the encoded proof breaks
+// verification.
+func testWireRoundTrip(t *testing.T) {
+	f := func(seed uint32, bitsSeed uint8) bool {
+		witness := make([]byte, 32)
+		binary.BigEndian.PutUint32(witness, seed)
+
+		bits := int(bitsSeed)%224 + 32 // keep round counts small but varied
+		sec := security.NewSecureQuantumZKP(bits)
+
+		commitment, err := sec.Commit(witness)
+		if err != nil {
+			t.Fatalf("Commit: %v", err)
+		}
+		proof, err := sec.Prove(commitment)
+		if err != nil {
+			t.Fatalf("Prove: %v", err)
+		}
+
+		cb, err := commitment.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Commitment.MarshalBinary: %v", err)
+		}
+		var gotCommitment security.Commitment
+		if err := gotCommitment.UnmarshalBinary(cb); err != nil {
+			t.Fatalf("Commitment.UnmarshalBinary: %v", err)
+		}
+		if !reflect.DeepEqual(*commitment, gotCommitment) {
+			return false
+		}
+
+		pb, err := proof.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Proof.MarshalBinary: %v", err)
+		}
+		var gotProof security.Proof
+		if err := gotProof.UnmarshalBinary(pb); err != nil {
+			t.Fatalf("Proof.UnmarshalBinary: %v", err)
+		}
+		if !reflect.DeepEqual(*proof, gotProof) {
+			return false
+		}
+
+		ok, err := sec.Verify(&gotCommitment, &gotProof)
+		if err != nil || !ok {
+			return false
+		}
+
+		// Mutating any single byte of the encoded proof must make
+		// verification fail, whether by decoding to different content or
+		// by being rejected outright.
+		mutated := append([]byte(nil), pb...)
+		mutated[int(seed%uint32(len(mutated)))] ^= 0xFF
+		var mutatedProof security.Proof
+		if err := mutatedProof.UnmarshalBinary(mutated); err != nil {
+			return true
+		}
+		ok, err = sec.Verify(&gotCommitment, &mutatedProof)
+		return err != nil || !ok
+	}
+
+	if err := quick.Check(f, &quick.Config{MaxCount: 64}); err != nil {
+		t.Fatalf("quick.Check: %v", err)
+	}
+}
+
+func testSealedWitnessAADBinding(t *testing.T) {
+	witness := make([]byte, 32)
+	if _, err := rand.Read(witness); err != nil {
+		t.Fatalf("rand.Read: %v", err)
+	}
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("rand.Read: %v", err)
+	}
+
+	digestA := []byte("commitment-digest-AAAAAAAAAAAAAA")
+	digestB := []byte("commitment-digest-BBBBBBBBBBBBBB")
+
+	sealed, err := security.Seal(witness, key, digestA)
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+
+	got, err := sealed.Open(key)
+	if err != nil {
+		t.Fatalf("Open with the original AAD: %v", err)
+	}
+	if !bytes.Equal(got, witness) {
+		t.Fatalf("Open returned the wrong witness")
+	}
+
+	// Swapping the associated commitment digest must invalidate Open.
+	swapped := *sealed
+	swapped.AAD = digestB
+	if _, err := swapped.Open(key); err == nil {
+		t.Fatalf("Open succeeded after swapping the associated commitment digest")
+	}
+}
+
+func testSealedWitnessNonceUniqueness(t *testing.T) {
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("rand.Read: %v", err)
+	}
+	witness := []byte("same witness every time")
+
+	const n = 10000
+	seen := make(map[string]bool, n)
+	for i := 0; i < n; i++ {
+		sealed, err := security.Seal(witness, key, nil)
+		if err != nil {
+			t.Fatalf("Seal: %v", err)
+		}
+		nonce := string(sealed.Nonce)
+		if seen[nonce] {
+			t.Fatalf("nonce reuse detected after %d seals", i)
+		}
+		seen[nonce] = true
+	}
+}
+
+func testSealedWitnessNoPlaintextLeakage(t *testing.T) {
+	witness := []byte("A5A5A5A5A5A5A5A5")
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("rand.Read: %v", err)
+	}
+
+	sealed, err := security.Seal(witness, key, nil)
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+
+	b, _ := json.Marshal(sealed)
+	if bytes.Contains(b, witness) {
+		t.Fatalf("json.Marshal(SealedWitness) leaked the plaintext witness")
+	}
+	hx := []byte(hex.EncodeToString(witness))
+	if bytes.Contains(bytes.ToLower(b), bytes.ToLower(hx)) {
+		t.Fatalf("json.Marshal(SealedWitness) leaked the hex-encoded witness")
+	}
+}
+
+func testCommitSealedRoundTrip(t *testing.T) {
+	witness := make([]byte, 32)
+	if _, err := rand.Read(witness); err != nil {
+		t.Fatalf("rand.Read: %v", err)
+	}
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("rand.Read: %v", err)
+	}
+
+	sec := security.NewSecureQuantumZKP(256)
+	commitment, sealed, err := sec.CommitSealed(witness, key)
+	if err != nil {
+		t.Fatalf("CommitSealed: %v", err)
+	}
+
+	got, err := sealed.Open(key)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if !bytes.Equal(got, witness) {
+		t.Fatalf("Open returned the wrong witness")
+	}
+
+	proof, err := sec.Prove(commitment)
+	if err != nil {
+		t.Fatalf("Prove: %v", err)
+	}
+	ok, err := sec.Verify(commitment, proof)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if !ok {
+		t.Fatalf("Verify returned false for the honest CommitSealed flow")
+	}
+}
+
 // --- Benchmarks (condensed) ---
 
 func BenchmarkReviewerSuite(b *testing.B) {
 	b.Run("CommitProveVerify_256", benchCommitProveVerify256)
+	b.Run("BatchVerify_256", benchBatchVerify256)
 }
 
 func benchCommitProveVerify256(b *testing.B) {
@@ -178,3 +509,46 @@ func benchCommitProveVerify256(b *testing.B) {
 	b.StopTimer()
 	_ = time.Now() // keep import honest on some toolchains
 }
+
+func benchBatchVerify256(b *testing.B) {
+	const n = 256
+
+	sec := security.NewSecureQuantumZKP(256)
+	commitments := make([]*security.Commitment, n)
+	proofs := make([]*security.Proof, n)
+
+	for i := 0; i < n; i++ {
+		witness := make([]byte, 32)
+		_, _ = rand.Read(witness)
+		commitment, err := sec.Commit(witness)
+		if err != nil {
+			b.Fatalf("Commit: %v", err)
+		}
+		proof, err := sec.Prove(commitment)
+		if err != nil {
+			b.Fatalf("Prove: %v", err)
+		}
+		commitments[i] = commitment
+		proofs[i] = proof
+	}
+
+	b.Run("Looped", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range commitments {
+				ok, err := sec.Verify(commitments[j], proofs[j])
+				if err != nil || !ok {
+					b.Fatalf("Verify failed: ok=%v err=%v", ok, err)
+				}
+			}
+		}
+	})
+
+	b.Run("Batched", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ok, bad, err := sec.VerifyBatch(commitments, proofs)
+			if err != nil || !ok || len(bad) != 0 {
+				b.Fatalf("VerifyBatch failed: ok=%v bad=%v err=%v", ok, bad, err)
+			}
+		}
+	})
+}
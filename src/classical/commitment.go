@@ -0,0 +1,24 @@
+// Package classical implements the classical (non-quantum) cryptographic
+// primitives that the qzkp protocol builds on: plain hash commitments used
+// to bind a prover to a witness before the interactive/Fiat-Shamir phase
+// begins.
+package classical
+
+import "crypto/sha256"
+
+// Commitment is a hiding, binding commitment to a value under a key. Only
+// the digest is ever serialized or transmitted; the value and key never
+// leave the prover.
+type Commitment struct {
+	Digest []byte `json:"digest"`
+}
+
+// CreateCommitment binds v under key, producing Commitment{Digest: H(v ||
+// key)}. Callers that need hiding against a verifier who can brute-force
+// low-entropy v should prefer CreateMaskedCommitment instead.
+func CreateCommitment(v, key []byte) Commitment {
+	h := sha256.New()
+	h.Write(v)
+	h.Write(key)
+	return Commitment{Digest: h.Sum(nil)}
+}
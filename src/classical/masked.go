@@ -0,0 +1,46 @@
+package classical
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+)
+
+// Opening reveals the blinding mask used in a masked commitment. The
+// verifier combines it with the already-known value and key to recompute
+// the digest; it should be withheld until the commitment actually needs
+// to be opened.
+type Opening struct {
+	Mask []byte
+}
+
+// CreateMaskedCommitment behaves like CreateCommitment but additionally
+// blinds the digest with a uniformly random mask r read from rnd, so that
+// H(v || key || r) no longer leaks statistical structure across repeated
+// commitments to the same (v, key) pair (hiding). The mask is returned
+// separately as an Opening, kept apart from the Commitment so it can be
+// withheld until the verifier actually needs it.
+func CreateMaskedCommitment(v, key []byte, rnd io.Reader) (Commitment, Opening, error) {
+	mask := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(rnd, mask); err != nil {
+		return Commitment{}, Opening{}, fmt.Errorf("classical: read mask: %w", err)
+	}
+
+	h := sha256.New()
+	h.Write(v)
+	h.Write(key)
+	h.Write(mask)
+
+	return Commitment{Digest: h.Sum(nil)}, Opening{Mask: mask}, nil
+}
+
+// VerifyMaskedCommitment reports whether opening correctly opens c for
+// value v under key.
+func VerifyMaskedCommitment(c Commitment, v, key []byte, opening Opening) bool {
+	h := sha256.New()
+	h.Write(v)
+	h.Write(key)
+	h.Write(opening.Mask)
+	return subtle.ConstantTimeCompare(h.Sum(nil), c.Digest) == 1
+}
@@ -0,0 +1,169 @@
+package security
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+// foldModulus is the field batch equations are folded over. It doesn't
+// need any special relationship to the transcript hash — any
+// sufficiently large prime gives a negligible forgery probability (see
+// foldProof) — so this reuses the secp256k1 base field prime, a
+// well-known, widely reviewed constant, rather than minting a new one.
+var foldModulus, _ = new(big.Int).SetString(
+	"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+
+// VerifyBatch verifies many (commitment, proof) pairs in a single
+// amortized pass using the same randomized-linear-combination trick as
+// batch Schnorr/Ed25519 verifiers: draw one fresh nonzero random scalar
+// per proof from a CSPRNG, fold every challenge/response equation into a
+// single aggregate check mod foldModulus, and only fall back to
+// verifying proofs one at a time if the aggregate check fails, so the
+// caller learns which indices were bad.
+//
+// If every equation matches, the aggregate always matches. If at least
+// one equation doesn't, foldProof's independent per-term coefficients
+// make the aggregate a nonzero polynomial in that proof's scalar, so a
+// random scalar is a root — and the aggregate check incorrectly passes
+// — with probability at most (2k-1)/foldModulus for k rounds per proof,
+// i.e. negligible.
+//
+// This is cheaper per call than N calls to Verify, but only because
+// Commit already paid the cost of deriving the expected transcript once
+// (see witnessRecord.expectedChallenges/expectedResponses); Verify
+// deliberately re-derives it independently every time instead of reading
+// the cache. The saving comes from that caching, not from the
+// aggregation/folding technique itself.
+func (s *SecureQuantumZKP) VerifyBatch(commitments []*Commitment, proofs []*Proof) (bool, []int, error) {
+	if len(commitments) != len(proofs) {
+		return false, nil, fmt.Errorf("security: VerifyBatch: %d commitments but %d proofs", len(commitments), len(proofs))
+	}
+	if len(commitments) == 0 {
+		return true, nil, nil
+	}
+
+	scalars := make([]*big.Int, len(commitments))
+	for i := range scalars {
+		scalar, err := drawFoldScalar()
+		if err != nil {
+			return false, nil, fmt.Errorf("security: VerifyBatch: draw scalar: %w", err)
+		}
+		scalars[i] = scalar
+	}
+
+	got, err := s.aggregateProofEquations(commitments, proofs, scalars)
+	if err != nil {
+		return false, nil, err
+	}
+	want, err := s.aggregateExpectedEquations(commitments, scalars)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if got.Cmp(want) == 0 {
+		return true, nil, nil
+	}
+
+	// The aggregate check failed, meaning at least one proof is bad.
+	// Fall back to per-proof verification to localize it.
+	var bad []int
+	for i := range commitments {
+		ok, err := s.Verify(commitments[i], proofs[i])
+		if err != nil {
+			return false, nil, err
+		}
+		if !ok {
+			bad = append(bad, i)
+		}
+	}
+	return false, bad, nil
+}
+
+// drawFoldScalar draws a uniformly random nonzero scalar in [1,
+// foldModulus). It must be nonzero: a zero scalar would make a proof's
+// entire contribution to the aggregate vanish regardless of whether its
+// equations are correct, defeating the check for that proof.
+func drawFoldScalar() (*big.Int, error) {
+	for {
+		s, err := rand.Int(rand.Reader, foldModulus)
+		if err != nil {
+			return nil, err
+		}
+		if s.Sign() != 0 {
+			return s, nil
+		}
+	}
+}
+
+// aggregateProofEquations folds every challenge/response pair claimed by
+// proofs into a single accumulator weighted by the corresponding scalar.
+func (s *SecureQuantumZKP) aggregateProofEquations(commitments []*Commitment, proofs []*Proof, scalars []*big.Int) (*big.Int, error) {
+	agg := new(big.Int)
+	for i, p := range proofs {
+		k := ChallengesForSecurityBits(commitments[i].SecurityBits)
+		if len(p.Challenges) != k || len(p.Responses) != k {
+			return nil, fmt.Errorf("security: VerifyBatch: proof %d has wrong round count", i)
+		}
+		foldProof(agg, scalars[i], p.Challenges, p.Responses)
+	}
+	return agg, nil
+}
+
+// aggregateExpectedEquations folds the same accumulator from the honest
+// challenge/response values, reading them straight out of the cache
+// populated at Commit time instead of re-deriving them.
+func (s *SecureQuantumZKP) aggregateExpectedEquations(commitments []*Commitment, scalars []*big.Int) (*big.Int, error) {
+	agg := new(big.Int)
+	for i, c := range commitments {
+		rec, ok := s.lookup(c)
+		if !ok {
+			return nil, fmt.Errorf("security: VerifyBatch: unknown commitment %d", i)
+		}
+		if err := s.checkHash(c); err != nil {
+			return nil, fmt.Errorf("security: VerifyBatch: commitment %d: %w", i, err)
+		}
+		k := ChallengesForSecurityBits(c.SecurityBits)
+		if len(rec.expectedChallenges) != k || len(rec.expectedResponses) != k {
+			return nil, fmt.Errorf("security: VerifyBatch: commitment %d has wrong round count", i)
+		}
+		foldProof(agg, scalars[i], rec.expectedChallenges, rec.expectedResponses)
+	}
+	return agg, nil
+}
+
+// foldProof folds one proof's full round transcript into agg, weighted by
+// scalar: round r's challenge gets coefficient scalar^(2r) and its
+// response gets coefficient scalar^(2r+1). Giving every term its own
+// power of scalar — rather than reusing one weight for both values in a
+// round, or across rounds — makes the folded sum a polynomial in scalar
+// whose coefficients are exactly the per-term differences between
+// claimed and expected values; if any differ, that polynomial is
+// nonzero, so it can vanish at a random scalar only with probability
+// bounded by its degree over foldModulus (Schwartz–Zippel). A forger
+// can't offset a wrong challenge with a compensating wrong response,
+// since they carry different, independent coefficients.
+//
+// Powers of scalar are computed incrementally (one multiplication per
+// term) rather than by repeated modular exponentiation, so this stays
+// cheap relative to a transcript-hash derivation.
+func foldProof(agg, scalar *big.Int, challenges, responses [][]byte) {
+	power := big.NewInt(1)
+	v := new(big.Int)
+	term := new(big.Int)
+	for r := range challenges {
+		v.SetBytes(challenges[r])
+		term.Mul(v, power)
+		agg.Add(agg, term)
+		agg.Mod(agg, foldModulus)
+		power.Mul(power, scalar)
+		power.Mod(power, foldModulus)
+
+		v.SetBytes(responses[r])
+		term.Mul(v, power)
+		agg.Add(agg, term)
+		agg.Mod(agg, foldModulus)
+		power.Mul(power, scalar)
+		power.Mod(power, foldModulus)
+	}
+}
@@ -0,0 +1,75 @@
+package security
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// SealedWitness is a witness encrypted under XChaCha20-Poly1305, bound to
+// an associated-data value (typically the digest of the Commitment it
+// belongs to) that is authenticated but not encrypted: it is kept as-is
+// in AAD, and only the witness is hidden. It can be persisted alongside a proof transcript without
+// revealing the witness to anyone but the holder of the AEAD key.
+type SealedWitness struct {
+	Nonce      []byte
+	Ciphertext []byte
+	AAD        []byte
+}
+
+// Seal encrypts witness under key with a fresh random 24-byte
+// XChaCha20-Poly1305 nonce, authenticating aad (typically a commitment
+// digest) without encrypting it.
+func Seal(witness, key, aad []byte) (*SealedWitness, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, fmt.Errorf("security: new XChaCha20-Poly1305: %w", err)
+	}
+
+	nonce := make([]byte, chacha20poly1305.NonceSizeX)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("security: generate nonce: %w", err)
+	}
+
+	return &SealedWitness{
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, witness, aad),
+		AAD:        append([]byte(nil), aad...),
+	}, nil
+}
+
+// Open decrypts and authenticates sw under key, returning the original
+// witness. It fails if key or sw.AAD don't match what Seal was called
+// with, or if the ciphertext has been tampered with.
+func (sw *SealedWitness) Open(key []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, fmt.Errorf("security: new XChaCha20-Poly1305: %w", err)
+	}
+
+	witness, err := aead.Open(nil, sw.Nonce, sw.Ciphertext, sw.AAD)
+	if err != nil {
+		return nil, fmt.Errorf("security: open sealed witness: %w", err)
+	}
+	return witness, nil
+}
+
+// CommitSealed behaves like Commit, but also seals witness under aeadKey
+// using the resulting commitment's digest as authenticated associated
+// data, so callers can persist the sealed witness safely alongside the
+// transcript: only the holder of aeadKey can recover it, and it can't be
+// replayed against a different commitment.
+func (s *SecureQuantumZKP) CommitSealed(witness, aeadKey []byte) (*Commitment, *SealedWitness, error) {
+	commitment, err := s.Commit(witness)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sealed, err := Seal(witness, aeadKey, commitment.Digest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commitment, sealed, nil
+}
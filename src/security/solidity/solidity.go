@@ -0,0 +1,214 @@
+// Package solidity emits standalone Solidity verifier contracts for
+// proofs produced by security.SecureQuantumZKP. A generated contract
+// checks that a proof's Fiat-Shamir transcript is correctly bound to its
+// commitment digest — the same challenge re-derivation security.Verify
+// performs — against the ICompatibleVerifier interface, so contracts
+// generated for different hash-to-field choices stay swappable. By
+// default the contract recomputes challenges via the EVM's SHA-256
+// precompile, matching security.SecureQuantumZKP's default transcript
+// hash byte-for-byte; see HashToField for the other, non-matching,
+// options.
+//
+// The knowledge-of-witness responses are, as in the rest of this
+// package, only checkable by a party holding the witness; a generated
+// contract checks transcript well-formedness, not full knowledge
+// soundness. See the package doc of security for the same caveat.
+package solidity
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+)
+
+// HashToField selects the on-chain hash used to recompute Fiat-Shamir
+// challenges from the transcript. It must agree with whatever transcript
+// hash the Go side's security.SecureQuantumZKP was configured with (see
+// security.WithTranscriptHash) or verifyProof will reject every honest
+// proof.
+type HashToField int
+
+const (
+	// HashToFieldSHA256 recomputes challenges via the EVM's SHA-256
+	// precompile (address 0x02), matching security.SecureQuantumZKP's
+	// default transcript hash (crypto/sha256) byte-for-byte. It's the
+	// only option that's guaranteed to agree with a default-configured Go
+	// instance, so it's the default (zero value).
+	HashToFieldSHA256 HashToField = iota
+	// HashToFieldKeccak256 uses the EVM's native keccak256. It's cheaper
+	// in gas than the SHA-256 precompile, but does NOT match any hash
+	// security.WithTranscriptHash can currently produce — sha256,
+	// blake2b-256, and sha3-256 (NIST, not Keccak padding) are all
+	// distinct from keccak256 — so a contract generated with this option
+	// will reject every proof from a Go-side Verify that returns true.
+	// Only use it if verifyProof's verdict doesn't need to match Go's.
+	HashToFieldKeccak256
+	// HashToFieldMiMC is a hook for a MiMC-like SNARK-friendly hash. Not
+	// implemented; falls back to keccak256 so the generated contract
+	// still compiles, and, like HashToFieldKeccak256, does not match any
+	// hash security.WithTranscriptHash can currently produce.
+	HashToFieldMiMC
+)
+
+func (h HashToField) String() string {
+	switch h {
+	case HashToFieldSHA256:
+		return "sha256"
+	case HashToFieldKeccak256:
+		return "keccak256"
+	case HashToFieldMiMC:
+		return "mimc"
+	default:
+		return fmt.Sprintf("HashToField(%d)", int(h))
+	}
+}
+
+// Params configures the generated verifier contract.
+type Params struct {
+	// ContractName is the Solidity contract identifier to emit. Defaults
+	// to "QZKPVerifier" if empty.
+	ContractName string
+	// SecurityBits is the security level the verifier checks proofs
+	// against; it also fixes the expected round count (see
+	// security.ChallengesForSecurityBits).
+	SecurityBits int
+	// HashToField selects the on-chain hash used to recompute challenges.
+	// Defaults to HashToFieldSHA256 (the zero value), which matches a
+	// default-configured security.SecureQuantumZKP. Set it to match
+	// whatever transcript hash the Go side actually uses.
+	HashToField HashToField
+}
+
+// EmitVerifier writes a standalone Solidity contract to w that checks the
+// Fiat-Shamir transcript of a (Commitment, Proof) pair produced by
+// security.SecureQuantumZKP for params.SecurityBits. The contract takes
+// the wire-encoded Commitment immediately followed by the wire-encoded
+// Proof (see the wire subpackage) as a single calldata blob.
+func EmitVerifier(w io.Writer, params Params) error {
+	if params.ContractName == "" {
+		params.ContractName = "QZKPVerifier"
+	}
+	if params.SecurityBits <= 0 {
+		return fmt.Errorf("solidity: SecurityBits must be positive, got %d", params.SecurityBits)
+	}
+	if params.SecurityBits > 0xFFFF {
+		return fmt.Errorf("solidity: SecurityBits must fit in 16 bits, got %d", params.SecurityBits)
+	}
+
+	data := struct {
+		ContractName string
+		SecurityBits int
+		Rounds       int
+		HashToField  string
+	}{
+		ContractName: params.ContractName,
+		SecurityBits: params.SecurityBits,
+		Rounds:       params.SecurityBits, // security.ChallengesForSecurityBits(bits) == bits
+		HashToField:  params.HashToField.String(),
+	}
+
+	return verifierTemplate.Execute(w, data)
+}
+
+var verifierTemplate = template.Must(template.New("verifier").Parse(verifierSource))
+
+const verifierSource = `// SPDX-License-Identifier: MIT
+// Code generated by security/solidity.EmitVerifier. DO NOT EDIT.
+pragma solidity ^0.8.19;
+
+interface ICompatibleVerifier {
+    function verifyProof(bytes calldata proof) external view returns (bool);
+}
+
+/// @title {{.ContractName}}
+/// @notice Checks Fiat-Shamir transcript well-formedness for QZKP proofs
+/// at {{.SecurityBits}}-bit security. Verifies that every challenge in
+/// the proof was correctly derived from the commitment digest and round
+/// counter; it does not re-derive the knowledge-of-witness responses,
+/// which are only checkable by a party holding the witness.
+contract {{.ContractName}} is ICompatibleVerifier {
+    uint16 private constant VERSION = 1;
+    uint16 private constant SECURITY_BITS = {{.SecurityBits}};
+    uint16 private constant ROUNDS = {{.Rounds}};
+    bytes4 private constant MAGIC = 0x515a4b50; // "QZKP"
+    uint256 private constant COMMITMENT_MSG_LEN = 10 + 32; // header + digest
+
+    /// @dev Hash-to-field used to recompute challenges on-chain.
+    /// Configured at generation time to {{.HashToField}}. Only "sha256"
+    /// (the default) is guaranteed to match security.SecureQuantumZKP's
+    /// transcript hash; see HashToField in the Go package for why
+    /// "keccak256" and "mimc" don't.
+    function hashToField(bytes memory data) private view returns (bytes32) {
+{{if eq .HashToField "keccak256"}}
+        return keccak256(data);
+{{else if eq .HashToField "mimc"}}
+        // MiMC-like hook: not implemented. Falls back to keccak256 so the
+        // generated contract still compiles; like keccak256, this does
+        // not match security.SecureQuantumZKP's transcript hash.
+        return keccak256(data);
+{{else}}
+        // SHA-256 precompile (address 0x02), matching Go's default
+        // transcript hash (crypto/sha256) byte-for-byte.
+        (bool ok, bytes memory out) = address(0x02).staticcall(data);
+        require(ok, "qzkp: sha256 precompile call failed");
+        return abi.decode(out, (bytes32));
+{{end}}
+    }
+
+    /// @notice proof is the wire-encoded Commitment immediately followed
+    /// by the wire-encoded Proof (see the Go wire subpackage).
+    function verifyProof(bytes calldata proof) external view override returns (bool) {
+        if (proof.length < COMMITMENT_MSG_LEN + 12) {
+            return false;
+        }
+
+        if (bytes4(proof[0:4]) != MAGIC || uint16(bytes2(proof[4:6])) != VERSION) {
+            return false;
+        }
+        bytes memory digest = proof[10:COMMITMENT_MSG_LEN];
+
+        uint256 offset = COMMITMENT_MSG_LEN;
+        if (bytes4(proof[offset:offset+4]) != MAGIC || uint16(bytes2(proof[offset+4:offset+6])) != VERSION) {
+            return false;
+        }
+        if (uint16(bytes2(proof[offset+8:offset+10])) != SECURITY_BITS) {
+            return false;
+        }
+        if (uint16(bytes2(proof[offset+10:offset+12])) != ROUNDS) {
+            return false;
+        }
+        offset += 12;
+
+        for (uint32 round = 0; round < ROUNDS; round++) {
+            bytes memory challenge;
+            (challenge, offset) = readBlob(proof, offset);
+            (, offset) = readBlob(proof, offset); // response: not re-derivable without the witness
+
+            bytes memory expected = abi.encodePacked(
+                "qzkp/challenge/v1",
+                SECURITY_BITS,
+                digest,
+                round
+            );
+            if (toBytes32(challenge) != hashToField(expected)) {
+                return false;
+            }
+        }
+
+        return offset == proof.length;
+    }
+
+    function readBlob(bytes calldata data, uint256 offset) private pure returns (bytes memory blob, uint256 next) {
+        uint32 length = uint32(bytes4(data[offset:offset+4]));
+        blob = data[offset+4:offset+4+length];
+        next = offset + 4 + length;
+    }
+
+    function toBytes32(bytes memory b) private pure returns (bytes32 result) {
+        require(b.length == 32, "qzkp: expected a 32-byte value");
+        assembly {
+            result := mload(add(b, 32))
+        }
+    }
+}
+`
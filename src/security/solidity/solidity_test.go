@@ -0,0 +1,184 @@
+package solidity_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/hydraresearch/qzkp/src/security"
+	"github.com/hydraresearch/qzkp/src/security/solidity"
+)
+
+func TestEmitVerifier(t *testing.T) {
+	var buf bytes.Buffer
+	if err := solidity.EmitVerifier(&buf, solidity.Params{SecurityBits: 128}); err != nil {
+		t.Fatalf("EmitVerifier: %v", err)
+	}
+
+	src := buf.String()
+	for _, want := range []string{
+		"pragma solidity",
+		"interface ICompatibleVerifier",
+		"contract QZKPVerifier is ICompatibleVerifier",
+		"SECURITY_BITS = 128",
+		"ROUNDS = 128",
+	} {
+		if !strings.Contains(src, want) {
+			t.Fatalf("emitted contract missing %q:\n%s", want, src)
+		}
+	}
+}
+
+func TestEmitVerifierRejectsBadParams(t *testing.T) {
+	var buf bytes.Buffer
+	if err := solidity.EmitVerifier(&buf, solidity.Params{SecurityBits: 0}); err == nil {
+		t.Fatalf("expected error for non-positive SecurityBits")
+	}
+}
+
+// TestEmitVerifierIntegration compiles the emitted contract with solc,
+// deploys it to a simulated EVM backend, and asserts that its
+// verifyProof verdict matches security.Verify's on both an honest and a
+// tampered proof. It's skipped when solc or abigen isn't on PATH, since
+// this toolchain isn't part of the normal Go build.
+func TestEmitVerifierIntegration(t *testing.T) {
+	solc, err := exec.LookPath("solc")
+	if err != nil {
+		t.Skip("solc not found on PATH; skipping on-chain integration test")
+	}
+	if _, err := exec.LookPath("abigen"); err != nil {
+		t.Skip("abigen not found on PATH; skipping on-chain integration test")
+	}
+
+	const bits = 32
+
+	dir := t.TempDir()
+	contractPath := filepath.Join(dir, "QZKPVerifier.sol")
+	f, err := os.Create(contractPath)
+	if err != nil {
+		t.Fatalf("create contract file: %v", err)
+	}
+	if err := solidity.EmitVerifier(f, solidity.Params{SecurityBits: bits}); err != nil {
+		f.Close()
+		t.Fatalf("EmitVerifier: %v", err)
+	}
+	f.Close()
+
+	out, err := exec.Command(solc, "--combined-json", "abi,bin", "--overwrite", contractPath).Output()
+	if err != nil {
+		t.Fatalf("solc: %v", err)
+	}
+
+	var combined struct {
+		Contracts map[string]struct {
+			ABI string `json:"abi"`
+			Bin string `json:"bin"`
+		} `json:"contracts"`
+	}
+	if err := json.Unmarshal(out, &combined); err != nil {
+		t.Fatalf("parse solc output: %v", err)
+	}
+
+	var abiJSON, binHex string
+	for key, c := range combined.Contracts {
+		if strings.HasSuffix(key, ":QZKPVerifier") {
+			abiJSON, binHex = c.ABI, c.Bin
+		}
+	}
+	if abiJSON == "" {
+		t.Fatalf("QZKPVerifier not found in solc output")
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("parse ABI: %v", err)
+	}
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("NewKeyedTransactorWithChainID: %v", err)
+	}
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		auth.From: {Balance: big.NewInt(1_000_000_000_000_000_000)},
+	})
+	defer backend.Close()
+	client := backend.Client()
+
+	contractAddr, _, _, err := bind.DeployContract(auth, parsedABI, common.FromHex(binHex), client)
+	if err != nil {
+		t.Fatalf("DeployContract: %v", err)
+	}
+	backend.Commit()
+
+	bound := bind.NewBoundContract(contractAddr, parsedABI, client, client, client)
+	callVerify := func(t *testing.T, commitment *security.Commitment, proof *security.Proof) bool {
+		t.Helper()
+		cb, err := commitment.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Commitment.MarshalBinary: %v", err)
+		}
+		pb, err := proof.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Proof.MarshalBinary: %v", err)
+		}
+
+		var results []interface{}
+		if err := bound.Call(&bind.CallOpts{}, &results, "verifyProof", append(cb, pb...)); err != nil {
+			t.Fatalf("verifyProof call: %v", err)
+		}
+		return results[0].(bool)
+	}
+
+	sec := security.NewSecureQuantumZKP(bits)
+	witness := make([]byte, 32)
+	if _, err := rand.Read(witness); err != nil {
+		t.Fatalf("rand.Read: %v", err)
+	}
+	commitment, err := sec.Commit(witness)
+	if err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	proof, err := sec.Prove(commitment)
+	if err != nil {
+		t.Fatalf("Prove: %v", err)
+	}
+
+	goOK, err := sec.Verify(commitment, proof)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if chainOK := callVerify(t, commitment, proof); chainOK != goOK {
+		t.Fatalf("on-chain verdict %v != Go Verify verdict %v for honest proof", chainOK, goOK)
+	}
+
+	tampered := *proof
+	tampered.Challenges = append([][]byte(nil), proof.Challenges...)
+	tampered.Challenges[0] = append([]byte(nil), proof.Challenges[0]...)
+	tampered.Challenges[0][0] ^= 0xFF
+
+	goOK, err = sec.Verify(commitment, &tampered)
+	if err != nil {
+		t.Fatalf("Verify (tampered): %v", err)
+	}
+	if chainOK := callVerify(t, commitment, &tampered); chainOK != goOK {
+		t.Fatalf("on-chain verdict %v != Go Verify verdict %v for tampered proof", chainOK, goOK)
+	}
+}
@@ -0,0 +1,207 @@
+// Package wire implements the compact, versioned on-wire encoding for
+// QZKP commitments and proofs: a fixed header, fixed-width slots for
+// commitment digests, and length-prefixed opaque blobs for the
+// variable-length challenge/response arrays. It replaces JSON as the
+// transcript format so proofs have a single, strict byte representation
+// with no ambiguity about what a decoder should accept.
+package wire
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Magic identifies the start of a qzkp wire-format message.
+const Magic = "QZKP"
+
+// Version is the only encoding version this package currently emits or
+// accepts.
+const Version uint16 = 1
+
+// DigestSize is the fixed width of a commitment digest slot.
+const DigestSize = 32
+
+// headerSize is len(Magic) + version(2) + suite(2) + security_bits(2).
+const headerSize = 4 + 2 + 2 + 2
+
+// Suite identifies the transcript hash a Commitment or Proof was produced
+// under.
+type Suite uint16
+
+// Supported suites. SuiteUnknown (the zero value) is never valid on the
+// wire.
+const (
+	SuiteUnknown Suite = iota
+	SuiteSHA256
+	SuiteBLAKE2b256
+	SuiteSHA3_256
+)
+
+var suiteLabels = map[Suite]string{
+	SuiteSHA256:     "sha256",
+	SuiteBLAKE2b256: "blake2b-256",
+	SuiteSHA3_256:   "sha3-256",
+}
+
+var labelSuites = map[string]Suite{
+	"sha256":      SuiteSHA256,
+	"blake2b-256": SuiteBLAKE2b256,
+	"sha3-256":    SuiteSHA3_256,
+}
+
+// Label returns the canonical transcript-hash label for s, or "" if s is
+// not a recognized suite.
+func (s Suite) Label() string {
+	return suiteLabels[s]
+}
+
+// SuiteFromLabel looks up the wire Suite code for a canonical transcript
+// hash label.
+func SuiteFromLabel(label string) (Suite, error) {
+	s, ok := labelSuites[label]
+	if !ok {
+		return SuiteUnknown, fmt.Errorf("wire: no suite registered for transcript hash label %q", label)
+	}
+	return s, nil
+}
+
+// Header is the fixed-width prefix of every wire-encoded Commitment or
+// Proof.
+type Header struct {
+	Version      uint16
+	Suite        Suite
+	SecurityBits uint16
+}
+
+func (h Header) encode() []byte {
+	buf := make([]byte, headerSize)
+	copy(buf[0:4], Magic)
+	binary.BigEndian.PutUint16(buf[4:6], h.Version)
+	binary.BigEndian.PutUint16(buf[6:8], uint16(h.Suite))
+	binary.BigEndian.PutUint16(buf[8:10], h.SecurityBits)
+	return buf
+}
+
+func decodeHeader(b []byte) (Header, []byte, error) {
+	if len(b) < headerSize {
+		return Header{}, nil, fmt.Errorf("wire: short header: %d bytes", len(b))
+	}
+	if string(b[0:4]) != Magic {
+		return Header{}, nil, fmt.Errorf("wire: bad magic %q", b[0:4])
+	}
+	version := binary.BigEndian.Uint16(b[4:6])
+	if version != Version {
+		return Header{}, nil, fmt.Errorf("wire: unsupported version %d", version)
+	}
+	h := Header{
+		Version:      version,
+		Suite:        Suite(binary.BigEndian.Uint16(b[6:8])),
+		SecurityBits: binary.BigEndian.Uint16(b[8:10]),
+	}
+	if _, ok := suiteLabels[h.Suite]; !ok {
+		return Header{}, nil, fmt.Errorf("wire: unknown suite %d", h.Suite)
+	}
+	return h, b[headerSize:], nil
+}
+
+// putBlob appends a 4-byte big-endian length prefix followed by b.
+func putBlob(buf, b []byte) []byte {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(b)))
+	buf = append(buf, length[:]...)
+	buf = append(buf, b...)
+	return buf
+}
+
+// takeBlob reads one length-prefixed blob off the front of b.
+func takeBlob(b []byte) (blob, rest []byte, err error) {
+	if len(b) < 4 {
+		return nil, nil, fmt.Errorf("wire: short blob length prefix")
+	}
+	n := binary.BigEndian.Uint32(b[0:4])
+	b = b[4:]
+	if uint64(len(b)) < uint64(n) {
+		return nil, nil, fmt.Errorf("wire: short blob body: want %d, have %d", n, len(b))
+	}
+	return b[:n], b[n:], nil
+}
+
+// EncodeCommitment serializes a commitment's header and fixed-width
+// digest into a single wire message.
+func EncodeCommitment(h Header, digest []byte) ([]byte, error) {
+	if len(digest) != DigestSize {
+		return nil, fmt.Errorf("wire: commitment digest must be %d bytes, got %d", DigestSize, len(digest))
+	}
+	buf := h.encode()
+	buf = append(buf, digest...)
+	return buf, nil
+}
+
+// DecodeCommitment parses a wire message produced by EncodeCommitment. It
+// rejects unknown versions and any trailing bytes.
+func DecodeCommitment(b []byte) (Header, []byte, error) {
+	h, rest, err := decodeHeader(b)
+	if err != nil {
+		return Header{}, nil, err
+	}
+	if len(rest) != DigestSize {
+		return Header{}, nil, fmt.Errorf("wire: commitment: expected %d digest bytes, got %d", DigestSize, len(rest))
+	}
+	digest := append([]byte(nil), rest...)
+	return h, digest, nil
+}
+
+// EncodeProof serializes a proof's header, round count, and
+// length-prefixed challenge/response blobs into a single wire message.
+func EncodeProof(h Header, challenges, responses [][]byte) ([]byte, error) {
+	if len(challenges) != len(responses) {
+		return nil, fmt.Errorf("wire: proof: %d challenges but %d responses", len(challenges), len(responses))
+	}
+	if len(challenges) > 0xFFFF {
+		return nil, fmt.Errorf("wire: proof: too many rounds: %d", len(challenges))
+	}
+
+	buf := h.encode()
+	var rounds [2]byte
+	binary.BigEndian.PutUint16(rounds[:], uint16(len(challenges)))
+	buf = append(buf, rounds[:]...)
+	for i := range challenges {
+		buf = putBlob(buf, challenges[i])
+		buf = putBlob(buf, responses[i])
+	}
+	return buf, nil
+}
+
+// DecodeProof parses a wire message produced by EncodeProof. It rejects
+// unknown versions and any trailing bytes.
+func DecodeProof(b []byte) (Header, [][]byte, [][]byte, error) {
+	h, rest, err := decodeHeader(b)
+	if err != nil {
+		return Header{}, nil, nil, err
+	}
+	if len(rest) < 2 {
+		return Header{}, nil, nil, fmt.Errorf("wire: proof: short round count")
+	}
+	rounds := int(binary.BigEndian.Uint16(rest[0:2]))
+	rest = rest[2:]
+
+	challenges := make([][]byte, rounds)
+	responses := make([][]byte, rounds)
+	for i := 0; i < rounds; i++ {
+		var challenge, response []byte
+		challenge, rest, err = takeBlob(rest)
+		if err != nil {
+			return Header{}, nil, nil, fmt.Errorf("wire: proof: round %d challenge: %w", i, err)
+		}
+		response, rest, err = takeBlob(rest)
+		if err != nil {
+			return Header{}, nil, nil, fmt.Errorf("wire: proof: round %d response: %w", i, err)
+		}
+		challenges[i] = challenge
+		responses[i] = response
+	}
+	if len(rest) != 0 {
+		return Header{}, nil, nil, fmt.Errorf("wire: proof: %d trailing bytes", len(rest))
+	}
+	return h, challenges, responses, nil
+}
@@ -0,0 +1,60 @@
+package security
+
+import (
+	"github.com/hydraresearch/qzkp/src/security/wire"
+)
+
+// MarshalBinary encodes c in the compact versioned wire format (see the
+// wire subpackage).
+func (c *Commitment) MarshalBinary() ([]byte, error) {
+	suite, err := wire.SuiteFromLabel(c.HashLabel)
+	if err != nil {
+		return nil, err
+	}
+	return wire.EncodeCommitment(wire.Header{
+		Version:      wire.Version,
+		Suite:        suite,
+		SecurityBits: uint16(c.SecurityBits),
+	}, c.Digest)
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary into c. It
+// rejects unknown versions and trailing bytes.
+func (c *Commitment) UnmarshalBinary(data []byte) error {
+	h, digest, err := wire.DecodeCommitment(data)
+	if err != nil {
+		return err
+	}
+	c.Digest = digest
+	c.SecurityBits = int(h.SecurityBits)
+	c.HashLabel = h.Suite.Label()
+	return nil
+}
+
+// MarshalBinary encodes p in the compact versioned wire format (see the
+// wire subpackage).
+func (p *Proof) MarshalBinary() ([]byte, error) {
+	suite, err := wire.SuiteFromLabel(p.HashLabel)
+	if err != nil {
+		return nil, err
+	}
+	return wire.EncodeProof(wire.Header{
+		Version:      wire.Version,
+		Suite:        suite,
+		SecurityBits: uint16(p.SecurityBits),
+	}, p.Challenges, p.Responses)
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary into p. It
+// rejects unknown versions and trailing bytes.
+func (p *Proof) UnmarshalBinary(data []byte) error {
+	h, challenges, responses, err := wire.DecodeProof(data)
+	if err != nil {
+		return err
+	}
+	p.Challenges = challenges
+	p.Responses = responses
+	p.SecurityBits = int(h.SecurityBits)
+	p.HashLabel = h.Suite.Label()
+	return nil
+}
@@ -0,0 +1,309 @@
+// Package security implements the QZKP (quantum zero-knowledge proof)
+// commit/prove/verify protocol: a prover commits to a witness, then proves
+// knowledge of it through a non-interactive Fiat-Shamir challenge-response
+// transcript, without ever revealing the witness itself.
+package security
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/hydraresearch/qzkp/src/classical"
+)
+
+// Commitment is the public commitment to a witness, carrying the security
+// level it was created under so Prove/Verify know how many challenge
+// rounds to expect, and a label identifying the transcript hash it was
+// created under so a Verify against a differently-configured instance is
+// rejected outright rather than silently mis-scoring.
+type Commitment struct {
+	Digest       []byte
+	SecurityBits int
+	HashLabel    string
+}
+
+// Proof is a non-interactive challenge-response transcript attesting to
+// knowledge of the witness behind a Commitment. It carries its own
+// security level and transcript-hash label so it is self-describing on
+// the wire (see the wire subpackage), independent of the Commitment it
+// was produced for.
+type Proof struct {
+	Challenges   [][]byte
+	Responses    [][]byte
+	SecurityBits int
+	HashLabel    string
+}
+
+// witnessRecord is the prover-side state kept for a commitment: the
+// witness, the key it was committed under, the masking opening returned
+// by classical.CreateMaskedCommitment, and the expected challenge/
+// response transcript. The expected transcript depends only on public
+// data (the commitment digest and round counter) plus the witness/key,
+// so it's derived once at Commit time and cached here, rather than
+// re-derived on every Prove/VerifyBatch call. It never leaves this
+// package.
+type witnessRecord struct {
+	witness []byte
+	key     []byte
+	opening classical.Opening
+
+	expectedChallenges [][]byte
+	expectedResponses  [][]byte
+}
+
+// SecureQuantumZKP drives commit/prove/verify for a fixed security level.
+// It keeps prover-side witness state so Prove and Verify can be called
+// against the same instance without re-threading the witness by hand.
+type SecureQuantumZKP struct {
+	bits int
+
+	mu             sync.Mutex
+	witnesses      map[string]witnessRecord
+	transcriptHash hash.Hash
+	hashLabel      string
+}
+
+// Option configures a SecureQuantumZKP at construction time.
+type Option func(*SecureQuantumZKP)
+
+// WithTranscriptHash selects the hash used to derive Fiat-Shamir
+// challenges and responses (the transcript hash). h must be dedicated to
+// this SecureQuantumZKP instance: it is Reset and reused internally for
+// every derivation, so it must not be shared with or mutated by other
+// code. Common choices are crypto/sha256 (the default if this option is
+// omitted), golang.org/x/crypto/blake2b, and golang.org/x/crypto/sha3.
+// The hash is fixed for the lifetime of the instance — there is
+// deliberately no way to change it afterwards, so a protocol run can
+// never swap transcript hashes mid-flight.
+func WithTranscriptHash(h hash.Hash) Option {
+	return func(s *SecureQuantumZKP) {
+		s.transcriptHash = h
+		s.hashLabel = classifyHash(h)
+	}
+}
+
+// classifyHash maps a hash.Hash to the canonical label used for
+// HashLabel fields and for the wire suite registry, so the same hash
+// choice always round-trips to the same label regardless of which
+// concrete (often unexported) type a package returns for it.
+func classifyHash(h hash.Hash) string {
+	t := fmt.Sprintf("%T", h)
+	switch {
+	case strings.Contains(t, "sha256"):
+		return "sha256"
+	case strings.Contains(t, "blake2b"):
+		return "blake2b-256"
+	case strings.Contains(t, "sha3"):
+		return "sha3-256"
+	default:
+		return t
+	}
+}
+
+// NewSecureQuantumZKP returns a prover/verifier configured for the given
+// security level in bits (e.g. 128, 256). By default challenges and
+// responses are derived with SHA-256; pass WithTranscriptHash to use a
+// different transcript hash.
+func NewSecureQuantumZKP(bits int, opts ...Option) *SecureQuantumZKP {
+	s := &SecureQuantumZKP{
+		bits:      bits,
+		witnesses: make(map[string]witnessRecord),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.transcriptHash == nil {
+		WithTranscriptHash(sha256.New())(s)
+	}
+	return s
+}
+
+// Commit binds the prover to witness without revealing it, returning the
+// public Commitment. The underlying commitment is masked (see
+// classical.CreateMaskedCommitment) so that committing to the same
+// witness twice never produces the same digest.
+func (s *SecureQuantumZKP) Commit(witness []byte) (*Commitment, error) {
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("security: generate commitment key: %w", err)
+	}
+
+	c, opening, err := classical.CreateMaskedCommitment(witness, key, rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("security: create masked commitment: %w", err)
+	}
+
+	k := ChallengesForSecurityBits(s.bits)
+	expectedChallenges := make([][]byte, k)
+	expectedResponses := make([][]byte, k)
+	for i := 0; i < k; i++ {
+		ch := s.deriveChallenge(c.Digest, i)
+		expectedChallenges[i] = ch
+		expectedResponses[i] = s.deriveResponse(witness, key, ch)
+	}
+
+	s.mu.Lock()
+	s.witnesses[string(c.Digest)] = witnessRecord{
+		witness:            append([]byte(nil), witness...),
+		key:                key,
+		opening:            opening,
+		expectedChallenges: expectedChallenges,
+		expectedResponses:  expectedResponses,
+	}
+	s.mu.Unlock()
+
+	return &Commitment{Digest: c.Digest, SecurityBits: s.bits, HashLabel: s.hashLabel}, nil
+}
+
+// Prove produces a Proof of knowledge of the witness behind commitment.
+// It returns an error if commitment was not produced by this instance,
+// or was produced under a different transcript hash. The returned
+// challenges/responses are copies of the transcript cached at Commit
+// time, so the work of deriving them is only ever paid once per
+// commitment, no matter how many times Prove or VerifyBatch is called
+// against it.
+func (s *SecureQuantumZKP) Prove(commitment *Commitment) (*Proof, error) {
+	if err := s.checkHash(commitment); err != nil {
+		return nil, err
+	}
+	rec, ok := s.lookup(commitment)
+	if !ok {
+		return nil, errors.New("security: unknown commitment")
+	}
+
+	k := ChallengesForSecurityBits(commitment.SecurityBits)
+	if len(rec.expectedChallenges) != k || len(rec.expectedResponses) != k {
+		return nil, fmt.Errorf("security: commitment has wrong round count for %d-bit security", commitment.SecurityBits)
+	}
+
+	return &Proof{
+		Challenges:   copyBlobs(rec.expectedChallenges),
+		Responses:    copyBlobs(rec.expectedResponses),
+		SecurityBits: commitment.SecurityBits,
+		HashLabel:    s.hashLabel,
+	}, nil
+}
+
+// Verify checks that proof attests to knowledge of the witness behind
+// commitment.
+func (s *SecureQuantumZKP) Verify(commitment *Commitment, proof *Proof) (bool, error) {
+	if err := s.checkHash(commitment); err != nil {
+		return false, err
+	}
+	rec, ok := s.lookup(commitment)
+	if !ok {
+		return false, errors.New("security: unknown commitment")
+	}
+
+	maskedCommitment := classical.Commitment{Digest: commitment.Digest}
+	if !classical.VerifyMaskedCommitment(maskedCommitment, rec.witness, rec.key, rec.opening) {
+		return false, nil
+	}
+
+	if proof.SecurityBits != commitment.SecurityBits || proof.HashLabel != commitment.HashLabel {
+		return false, nil
+	}
+
+	k := ChallengesForSecurityBits(commitment.SecurityBits)
+	if len(proof.Challenges) != k || len(proof.Responses) != k {
+		return false, nil
+	}
+
+	for i := 0; i < k; i++ {
+		if !bytes.Equal(proof.Challenges[i], s.deriveChallenge(commitment.Digest, i)) {
+			return false, nil
+		}
+		want := s.deriveResponse(rec.witness, rec.key, proof.Challenges[i])
+		if !bytes.Equal(proof.Responses[i], want) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// copyBlobs returns a deep copy of blobs, so callers handed a Proof built
+// from cached transcript data can freely mutate it (e.g. in tests) without
+// corrupting the cache backing future Prove/VerifyBatch calls.
+func copyBlobs(blobs [][]byte) [][]byte {
+	out := make([][]byte, len(blobs))
+	for i, b := range blobs {
+		out[i] = append([]byte(nil), b...)
+	}
+	return out
+}
+
+func (s *SecureQuantumZKP) lookup(commitment *Commitment) (witnessRecord, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.witnesses[string(commitment.Digest)]
+	return rec, ok
+}
+
+// checkHash rejects a commitment produced under a different transcript
+// hash than this instance is configured with, instead of silently
+// deriving challenges/responses that can never match.
+func (s *SecureQuantumZKP) checkHash(commitment *Commitment) error {
+	if commitment.HashLabel != s.hashLabel {
+		return fmt.Errorf("security: commitment was created with transcript hash %q, this instance uses %q", commitment.HashLabel, s.hashLabel)
+	}
+	return nil
+}
+
+// transcriptSum absorbs parts into the configured transcript hash, in
+// order, and returns the digest. It owns the hash's mutable state, so
+// access is serialized through s.mu.
+func (s *SecureQuantumZKP) transcriptSum(parts ...[]byte) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.transcriptHash.Reset()
+	for _, p := range parts {
+		s.transcriptHash.Write(p)
+	}
+	return s.transcriptHash.Sum(nil)
+}
+
+// deriveChallenge derives the i-th Fiat-Shamir challenge for a
+// commitment. The transcript is strictly domain-separated: a protocol
+// label, the security level, the commitment digest, then the round
+// counter, absorbed in that fixed order, so challenges can't be confused
+// with responses or reused across protocols, security levels, or rounds.
+func (s *SecureQuantumZKP) deriveChallenge(digest []byte, round int) []byte {
+	return s.transcriptSum(
+		[]byte("qzkp/challenge/v1"),
+		[]byte{byte(s.bits >> 8), byte(s.bits)},
+		digest,
+		[]byte{byte(round >> 24), byte(round >> 16), byte(round >> 8), byte(round)},
+	)
+}
+
+// deriveResponse computes the prover's response to challenge for a given
+// witness/key pair, under the same domain-separated transcript.
+func (s *SecureQuantumZKP) deriveResponse(witness, key, challenge []byte) []byte {
+	return s.transcriptSum(
+		[]byte("qzkp/response/v1"),
+		[]byte{byte(s.bits >> 8), byte(s.bits)},
+		witness,
+		key,
+		challenge,
+	)
+}
+
+// ChallengesForSecurityBits maps a target security level to the number of
+// Fiat-Shamir challenge rounds needed so that the soundness error is
+// 2^-bits.
+func ChallengesForSecurityBits(bits int) int {
+	return bits
+}
+
+// SoundnessErrorForChallenges returns the probability that a cheating
+// prover passes k independent challenge rounds, i.e. 2^-k.
+func SoundnessErrorForChallenges(k int) float64 {
+	return math.Pow(0.5, float64(k))
+}